@@ -0,0 +1,37 @@
+package checks
+
+import "sync"
+
+// Accumulator collects check results reported while walking the plan (or
+// apply) graph, so that they can be assembled into a Plan once the walk
+// completes and then attached to the corresponding plans.Plan.
+type Accumulator struct {
+	mu      sync.Mutex
+	results []*Result
+}
+
+// NewAccumulator returns an empty Accumulator, ready to have results
+// reported into it.
+func NewAccumulator() *Accumulator {
+	return &Accumulator{}
+}
+
+// Report records the results produced by one evaluation of a check rule
+// set. It's safe to call concurrently, since a plan graph walk evaluates
+// many resource instances and outputs in parallel.
+func (a *Accumulator) Report(results []*Result) {
+	if len(results) == 0 {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.results = append(a.results, results...)
+}
+
+// Plan assembles everything reported so far into a Plan, ready to attach
+// to a plans.Plan once the graph walk that produced it has completed.
+func (a *Accumulator) Plan() *Plan {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return NewPlan(a.results)
+}
@@ -0,0 +1,34 @@
+package checks
+
+// Plan is the aggregated set of check results produced by a single plan
+// (or apply) walk, in the form that gets attached to the corresponding
+// plans.Plan so that it can be carried alongside the plan and serialized
+// by consumers such as "terraform show -json".
+type Plan struct {
+	Results []*Result
+}
+
+// NewPlan builds a Plan from the check results collected while walking
+// the graph. Results are kept in the order they were evaluated so that
+// output ordering is stable and deterministic.
+func NewPlan(results []*Result) *Plan {
+	return &Plan{
+		Results: results,
+	}
+}
+
+// ByAddress returns only the results recorded against the given checkable
+// address, in case a caller wants to inspect a single object's outcome
+// rather than the whole plan's.
+func (p *Plan) ByAddress(addr string) []*Result {
+	if p == nil {
+		return nil
+	}
+	var ret []*Result
+	for _, result := range p.Results {
+		if result.Address.String() == addr {
+			ret = append(ret, result)
+		}
+	}
+	return ret
+}
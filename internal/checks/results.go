@@ -0,0 +1,55 @@
+// Package checks contains the types used to record the outcome of
+// condition checks (resource preconditions/postconditions, output
+// preconditions, and similar) as structured data, so that callers other
+// than the human-oriented diagnostic renderer can consume them.
+package checks
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+)
+
+// Type describes which kind of check rule a Result was produced from.
+type Type int
+
+const (
+	TypeInvalid Type = iota
+	TypeResourcePrecondition
+	TypeResourcePostcondition
+	TypeOutputPrecondition
+	TypeVariableValidation
+)
+
+// Status describes the outcome of evaluating a single check rule.
+type Status int
+
+const (
+	// StatusUnknown means that the check could not yet be evaluated
+	// because its condition depended on a value that wasn't known at the
+	// time, and so it may still pass or fail once more information is
+	// available.
+	StatusUnknown Status = iota
+
+	// StatusPass means that the check's condition evaluated to true.
+	StatusPass
+
+	// StatusFail means that the check's condition evaluated to false.
+	StatusFail
+
+	// StatusError means that the check could not be evaluated at all,
+	// for example because the condition or error message expression
+	// itself was invalid.
+	StatusError
+)
+
+// Result is a single recorded outcome of evaluating a check rule, in a
+// form that's suitable for serializing as part of a plan or carrying
+// alongside one, rather than just being rendered as a diagnostic message.
+type Result struct {
+	Address addrs.Checkable
+	Type    Type
+	Status  Status
+	Message string
+	Range   hcl.Range
+}
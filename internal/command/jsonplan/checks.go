@@ -0,0 +1,123 @@
+package jsonplan
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/hashicorp/terraform/internal/checks"
+)
+
+// checkResultStatus is the string representation of a checks.Status used
+// in the "checks" array of the plan JSON output.
+type checkResultStatus string
+
+const (
+	checkResultStatusPass    checkResultStatus = "pass"
+	checkResultStatusFail    checkResultStatus = "fail"
+	checkResultStatusError   checkResultStatus = "error"
+	checkResultStatusUnknown checkResultStatus = "unknown"
+)
+
+func statusString(s checks.Status) checkResultStatus {
+	switch s {
+	case checks.StatusPass:
+		return checkResultStatusPass
+	case checks.StatusFail:
+		return checkResultStatusFail
+	case checks.StatusError:
+		return checkResultStatusError
+	default:
+		return checkResultStatusUnknown
+	}
+}
+
+// checkResultType is the string representation of a checks.Type used in
+// the "checks" array of the plan JSON output, so that a consumer can
+// tell a resource precondition apart from a postcondition, an output
+// precondition, or a variable validation.
+type checkResultType string
+
+const (
+	checkResultTypeResourcePrecondition  checkResultType = "resource_precondition"
+	checkResultTypeResourcePostcondition checkResultType = "resource_postcondition"
+	checkResultTypeOutputPrecondition    checkResultType = "output_precondition"
+	checkResultTypeVariableValidation    checkResultType = "variable_validation"
+	checkResultTypeInvalid               checkResultType = "invalid"
+)
+
+func typeString(t checks.Type) checkResultType {
+	switch t {
+	case checks.TypeResourcePrecondition:
+		return checkResultTypeResourcePrecondition
+	case checks.TypeResourcePostcondition:
+		return checkResultTypeResourcePostcondition
+	case checks.TypeOutputPrecondition:
+		return checkResultTypeOutputPrecondition
+	case checks.TypeVariableValidation:
+		return checkResultTypeVariableValidation
+	default:
+		return checkResultTypeInvalid
+	}
+}
+
+// pos is the JSON representation of one end of a source range, matching
+// the shape used elsewhere in the plan JSON output for diagnostic source
+// ranges.
+type pos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+	Byte   int `json:"byte"`
+}
+
+// sourceRange is the JSON representation of an hcl.Range.
+type sourceRange struct {
+	Filename string `json:"filename"`
+	Start    pos    `json:"start"`
+	End      pos    `json:"end"`
+}
+
+func marshalRange(rng hcl.Range) sourceRange {
+	return sourceRange{
+		Filename: rng.Filename,
+		Start: pos{
+			Line:   rng.Start.Line,
+			Column: rng.Start.Column,
+			Byte:   rng.Start.Byte,
+		},
+		End: pos{
+			Line:   rng.End.Line,
+			Column: rng.End.Column,
+			Byte:   rng.End.Byte,
+		},
+	}
+}
+
+// checkResult is the JSON representation of a single checks.Result,
+// included in the top-level "checks" array of `terraform show -json`
+// plan output so that CI systems and policy tooling can consume
+// condition outcomes without scraping human-formatted diagnostics.
+type checkResult struct {
+	Address string            `json:"address"`
+	Type    checkResultType   `json:"type"`
+	Status  checkResultStatus `json:"status"`
+	Message string            `json:"message,omitempty"`
+	Range   sourceRange       `json:"range"`
+}
+
+// marshalCheckResults converts the check results recorded on a plan into
+// their JSON representation, preserving evaluation order.
+func marshalCheckResults(plan *checks.Plan) []checkResult {
+	if plan == nil {
+		return nil
+	}
+	ret := make([]checkResult, 0, len(plan.Results))
+	for _, result := range plan.Results {
+		ret = append(ret, checkResult{
+			Address: result.Address.String(),
+			Type:    typeString(result.Type),
+			Status:  statusString(result.Status),
+			Message: result.Message,
+			Range:   marshalRange(result.Range),
+		})
+	}
+	return ret
+}
@@ -0,0 +1,29 @@
+package jsonplan
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform/internal/plans"
+)
+
+// FormatVersion is the version of the JSON plan format produced by
+// Marshal, included in every plan so that consumers can tell which
+// shape of document they're reading.
+const FormatVersion = "1.2"
+
+// plan is the root of the JSON document produced by Marshal.
+type plan struct {
+	FormatVersion string        `json:"format_version"`
+	Checks        []checkResult `json:"checks,omitempty"`
+}
+
+// Marshal renders a plans.Plan as the JSON document consumed by
+// "terraform show -json", including a "checks" array populated from the
+// plan's aggregated condition check results.
+func Marshal(p *plans.Plan) ([]byte, error) {
+	output := plan{
+		FormatVersion: FormatVersion,
+		Checks:        marshalCheckResults(p.Checks),
+	}
+	return json.Marshal(output)
+}
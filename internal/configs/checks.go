@@ -0,0 +1,58 @@
+package configs
+
+import (
+	"github.com/hashicorp/hcl/v2"
+)
+
+// CheckRule represents a configuration-defined validation rule, precondition,
+// or postcondition. Blocks of this sort can appear in a few different
+// locations in the configuration, including "validation" blocks for
+// variables, and "precondition"/"postcondition" blocks for resources and
+// outputs.
+type CheckRule struct {
+	// Condition is an expression that must evaluate to true in order for
+	// the check to pass.
+	Condition hcl.Expression
+
+	// ErrorMessage is an expression that renders to the message to show
+	// the user when Condition evaluates to false. It's an expression,
+	// rather than a plain string, so that it can interpolate values from
+	// the evaluation context to help the user understand why the check
+	// failed, e.g. `"Expected AMI ${var.expected_ami}, got ${self.ami}."`.
+	ErrorMessage hcl.Expression
+
+	DeclRange hcl.Range
+}
+
+var checkRuleBlockSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "condition",
+			Required: true,
+		},
+		{
+			Name:     "error_message",
+			Required: true,
+		},
+	},
+}
+
+func decodeCheckRuleBlock(block *hcl.Block, override bool) (*CheckRule, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	cr := &CheckRule{
+		DeclRange: block.DefRange,
+	}
+
+	content, moreDiags := block.Body.Content(checkRuleBlockSchema)
+	diags = append(diags, moreDiags...)
+
+	if attr, exists := content.Attributes["condition"]; exists {
+		cr.Condition = attr.Expr
+	}
+
+	if attr, exists := content.Attributes["error_message"]; exists {
+		cr.ErrorMessage = attr.Expr
+	}
+
+	return cr, diags
+}
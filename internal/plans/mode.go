@@ -0,0 +1,39 @@
+package plans
+
+// Mode represents the planning mode that produced (or is about to
+// produce) a Plan: the overall strategy Terraform is using to decide
+// what actions a plan should propose.
+type Mode rune
+
+const (
+	// NormalMode is the default planning mode, where Terraform compares
+	// the desired state described by the configuration with the
+	// previous run state and proposes a set of actions to reconcile
+	// them.
+	NormalMode Mode = 0
+
+	// RefreshOnlyMode is a special mode where Terraform only updates its
+	// record of the prior state to match reality, without proposing any
+	// changes to reach the desired state. Condition failures in this
+	// mode are reported as warnings rather than errors, since there's no
+	// new plan for them to block.
+	RefreshOnlyMode Mode = 'R'
+
+	// DestroyMode is a special mode where Terraform plans to destroy all
+	// of the remote objects that it's currently tracking, regardless of
+	// what the configuration calls for.
+	DestroyMode Mode = 'D'
+)
+
+func (m Mode) String() string {
+	switch m {
+	case NormalMode:
+		return "normal"
+	case RefreshOnlyMode:
+		return "refresh-only"
+	case DestroyMode:
+		return "destroy"
+	default:
+		return "invalid"
+	}
+}
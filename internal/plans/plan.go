@@ -0,0 +1,20 @@
+package plans
+
+import (
+	"github.com/hashicorp/terraform/internal/checks"
+)
+
+// Plan describes the set of actions Terraform has proposed in order to
+// move from the current state to the desired state described by the
+// configuration, along with any other information gathered while
+// producing that proposal.
+type Plan struct {
+	// Checks is the aggregate set of results from evaluating all of the
+	// condition checks (resource preconditions/postconditions, output
+	// preconditions, and variable validations) encountered while
+	// building this plan. It's populated by the graph walk that
+	// produced the plan, and consumed by callers such as the
+	// "terraform show -json" formatter that want structured access to
+	// condition outcomes rather than scraping diagnostic messages.
+	Checks *checks.Plan
+}
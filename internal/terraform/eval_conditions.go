@@ -9,12 +9,33 @@ import (
 	"github.com/zclconf/go-cty/cty/convert"
 
 	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
 	"github.com/hashicorp/terraform/internal/configs"
 	"github.com/hashicorp/terraform/internal/instances"
 	"github.com/hashicorp/terraform/internal/lang"
+	"github.com/hashicorp/terraform/internal/plans"
 	"github.com/hashicorp/terraform/internal/tfdiags"
 )
 
+// evaluationScope is the subset of *lang.Scope that evalCheckRules needs
+// in order to turn a condition's references into an *hcl.EvalContext.
+// It's expressed as its own interface, rather than depending on
+// *lang.Scope directly, so that check evaluation can be exercised in
+// tests without having to stand up a full scope.
+type evaluationScope interface {
+	EvalContext(refs []*addrs.Reference) (*hcl.EvalContext, tfdiags.Diagnostics)
+}
+
+// checkEvalContext is the subset of EvalContext that evalCheckRules and
+// its callers need: enough to build an evaluation scope for a condition,
+// to know which plan mode is in effect, and to report results onto the
+// accumulator for the current graph walk.
+type checkEvalContext interface {
+	EvaluationScope(self addrs.Referenceable, keyData instances.RepetitionData) evaluationScope
+	PlanMode() plans.Mode
+	Checks() *checks.Accumulator
+}
+
 type checkType int
 
 const (
@@ -22,6 +43,7 @@ const (
 	checkResourcePrecondition  checkType = 1
 	checkResourcePostcondition checkType = 2
 	checkOutputPrecondition    checkType = 3
+	checkVariableValidation    checkType = 4
 )
 
 func (c checkType) FailureSummary() string {
@@ -32,12 +54,46 @@ func (c checkType) FailureSummary() string {
 		return "Resource postcondition failed"
 	case checkOutputPrecondition:
 		return "Module output value precondition failed"
+	case checkVariableValidation:
+		return "Invalid value for variable"
 	default:
 		// This should not happen
 		return "Failed condition for invalid check type"
 	}
 }
 
+// checksType translates a checkType into the corresponding checks.Type,
+// for recording in a checks.Result.
+func (c checkType) checksType() checks.Type {
+	switch c {
+	case checkResourcePrecondition:
+		return checks.TypeResourcePrecondition
+	case checkResourcePostcondition:
+		return checks.TypeResourcePostcondition
+	case checkOutputPrecondition:
+		return checks.TypeOutputPrecondition
+	case checkVariableValidation:
+		return checks.TypeVariableValidation
+	default:
+		return checks.TypeInvalid
+	}
+}
+
+// severityForPlanMode determines what hcl.DiagnosticSeverity a failed
+// check rule should be reported at for the given plan mode.
+//
+// Normally a failed check blocks the plan, and so it's reported as an
+// error. During a refresh-only plan there's no new plan for the failure
+// to block -- the point of that mode is only to reconcile state with
+// reality -- so a failure is downgraded to a warning instead, to avoid
+// aborting the refresh over a condition that was already true before.
+func severityForPlanMode(mode plans.Mode) hcl.DiagnosticSeverity {
+	if mode == plans.RefreshOnlyMode {
+		return hcl.DiagWarning
+	}
+	return hcl.DiagError
+}
+
 // evalCheckRules ensures that all of the given check rules pass against
 // the given HCL evaluation context.
 //
@@ -47,12 +103,29 @@ func (c checkType) FailureSummary() string {
 //
 // If any of the rules do not pass, the returned diagnostics will contain
 // errors. Otherwise, it will either be empty or contain only warnings.
-func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext, self addrs.Referenceable, keyData instances.RepetitionData) (diags tfdiags.Diagnostics) {
+//
+// Normally failed conditions are reported as errors, but during a
+// refresh-only plan the only thing Terraform is doing is reconciling state
+// with reality, so there's no new plan for a failing condition to block.
+// In that mode a failure is reported as a warning instead, because the
+// alternative would be the user never getting to see the rest of the
+// refresh result at all.
+//
+// In addition to diagnostics, evalCheckRules returns a checks.Result for
+// every rule it evaluated, including the ones that passed. Those results
+// are also reported onto ctx.Checks(), the accumulator for the current
+// graph walk, so that once the walk completes they can be assembled into
+// a checks.Plan and attached to the plans.Plan for consumers like
+// "terraform show -json" that want to consume condition outcomes as
+// structured data rather than scraping diagnostic messages.
+func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx checkEvalContext, self addrs.Referenceable, checkAddr addrs.Checkable, keyData instances.RepetitionData) (results []*checks.Result, diags tfdiags.Diagnostics) {
 	if len(rules) == 0 {
 		// Nothing to do
-		return nil
+		return nil, nil
 	}
 
+	severity := severityForPlanMode(ctx.PlanMode())
+
 	for _, rule := range rules {
 		const errInvalidCondition = "Invalid condition result"
 		var ruleDiags tfdiags.Diagnostics
@@ -72,6 +145,18 @@ func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext,
 		}
 
 		if !result.IsKnown() {
+			// A condition that failed to evaluate also produces an unknown
+			// value, but that's an error rather than a pending result.
+			status := checks.StatusUnknown
+			if ruleDiags.HasErrors() {
+				status = checks.StatusError
+			}
+			results = append(results, &checks.Result{
+				Address: checkAddr,
+				Type:    typ.checksType(),
+				Status:  status,
+				Range:   rule.Condition.Range(),
+			})
 			continue // We'll wait until we've learned more, then.
 		}
 		if result.IsNull() {
@@ -83,6 +168,13 @@ func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext,
 				Expression:  rule.Condition,
 				EvalContext: hclCtx,
 			})
+			results = append(results, &checks.Result{
+				Address: checkAddr,
+				Type:    typ.checksType(),
+				Status:  checks.StatusError,
+				Message: "Condition expression must return either true or false, not null.",
+				Range:   rule.Condition.Range(),
+			})
 			continue
 		}
 		var err error
@@ -96,20 +188,99 @@ func evalCheckRules(typ checkType, rules []*configs.CheckRule, ctx EvalContext,
 				Expression:  rule.Condition,
 				EvalContext: hclCtx,
 			})
+			results = append(results, &checks.Result{
+				Address: checkAddr,
+				Type:    typ.checksType(),
+				Status:  checks.StatusError,
+				Message: fmt.Sprintf("Invalid validation condition result value: %s.", tfdiags.FormatError(err)),
+				Range:   rule.Condition.Range(),
+			})
 			continue
 		}
 
 		if result.False() {
+			messageResult, moreDiags := evalCheckRuleMessage(rule, hclCtx)
+			if moreDiags.HasErrors() {
+				// It's likely that the author of the message expression
+				// made a mistake, so we still want the user to see the
+				// original condition failure as well as the problem with
+				// the message itself.
+				messageResult = "Failed to evaluate error message: " + moreDiags.Err().Error()
+			}
 			diags = diags.Append(&hcl.Diagnostic{
-				Severity:    hcl.DiagError,
+				Severity:    severity,
 				Summary:     typ.FailureSummary(),
-				Detail:      rule.ErrorMessage,
+				Detail:      messageResult,
 				Subject:     rule.Condition.Range().Ptr(),
 				Expression:  rule.Condition,
 				EvalContext: hclCtx,
 			})
+			diags = diags.Append(moreDiags)
+			results = append(results, &checks.Result{
+				Address: checkAddr,
+				Type:    typ.checksType(),
+				Status:  checks.StatusFail,
+				Message: messageResult,
+				Range:   rule.Condition.Range(),
+			})
+		} else {
+			// We still evaluate the error message expression even when the
+			// condition passed, so that authoring mistakes in the message
+			// template are caught right away rather than only showing up
+			// when the condition eventually fails.
+			_, moreDiags := evalCheckRuleMessage(rule, hclCtx)
+			diags = diags.Append(moreDiags)
+
+			status := checks.StatusPass
+			if moreDiags.HasErrors() {
+				status = checks.StatusError
+			}
+			results = append(results, &checks.Result{
+				Address: checkAddr,
+				Type:    typ.checksType(),
+				Status:  status,
+				Range:   rule.Condition.Range(),
+			})
+		}
+	}
+
+	ctx.Checks().Report(results)
+	return results, diags
+}
+
+// evalCheckRuleMessage evaluates the error message expression of a check
+// rule against the given HCL evaluation context, returning the rendered
+// message as a string.
+//
+// This is called both when a condition fails, to produce the message the
+// user sees, and when a condition passes, so that mistakes in the message
+// template are reported as soon as possible rather than waiting for the
+// condition to eventually fail.
+func evalCheckRuleMessage(rule *configs.CheckRule, hclCtx *hcl.EvalContext) (string, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	val, hclDiags := rule.ErrorMessage.Value(hclCtx)
+	diags = diags.Append(hclDiags)
+	if hclDiags.HasErrors() {
+		return "", diags
+	}
+
+	val, err := convert.Convert(val, cty.String)
+	if err != nil || val.IsNull() {
+		detail := "Error message expression must evaluate to a string."
+		if err != nil {
+			detail = fmt.Sprintf("Invalid error message: %s.", tfdiags.FormatError(err))
 		}
+		diags = diags.Append(&hcl.Diagnostic{
+			Severity:    hcl.DiagError,
+			Summary:     "Invalid error message",
+			Detail:      detail,
+			Subject:     rule.ErrorMessage.Range().Ptr(),
+			Expression:  rule.ErrorMessage,
+			EvalContext: hclCtx,
+		})
+		return "", diags
 	}
 
-	return diags
+	return val.AsString(), diags
 }
@@ -0,0 +1,170 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
+	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/instances"
+	"github.com/hashicorp/terraform/internal/plans"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+func TestSeverityForPlanMode(t *testing.T) {
+	tests := map[string]struct {
+		mode plans.Mode
+		want hcl.DiagnosticSeverity
+	}{
+		"normal mode reports errors": {
+			mode: plans.NormalMode,
+			want: hcl.DiagError,
+		},
+		"refresh-only mode downgrades to warnings": {
+			mode: plans.RefreshOnlyMode,
+			want: hcl.DiagWarning,
+		},
+		"destroy mode reports errors": {
+			mode: plans.DestroyMode,
+			want: hcl.DiagError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := severityForPlanMode(test.mode)
+			if got != test.want {
+				t.Errorf("wrong severity\ngot:  %v\nwant: %v", got, test.want)
+			}
+		})
+	}
+}
+
+// fakeScope is a no-op evaluationScope, sufficient for conditions that
+// don't reference anything.
+type fakeScope struct{}
+
+func (fakeScope) EvalContext(refs []*addrs.Reference) (*hcl.EvalContext, tfdiags.Diagnostics) {
+	return &hcl.EvalContext{}, nil
+}
+
+// fakeCheckEvalContext is a minimal checkEvalContext for exercising check
+// evaluation in a particular plan mode without a full EvalContext.
+type fakeCheckEvalContext struct {
+	mode        plans.Mode
+	accumulator *checks.Accumulator
+}
+
+func (c *fakeCheckEvalContext) EvaluationScope(self addrs.Referenceable, keyData instances.RepetitionData) evaluationScope {
+	return fakeScope{}
+}
+
+func (c *fakeCheckEvalContext) PlanMode() plans.Mode {
+	return c.mode
+}
+
+func (c *fakeCheckEvalContext) Checks() *checks.Accumulator {
+	if c.accumulator == nil {
+		c.accumulator = checks.NewAccumulator()
+	}
+	return c.accumulator
+}
+
+// fakeReadResourceProvider is a resourceReader that only ever needs to
+// answer ReadResource, recording whether it was called.
+type fakeReadResourceProvider struct {
+	called   bool
+	newState cty.Value
+}
+
+func (p *fakeReadResourceProvider) ReadResource(req providers.ReadResourceRequest) providers.ReadResourceResponse {
+	p.called = true
+	return providers.ReadResourceResponse{
+		NewState: p.newState,
+	}
+}
+
+func failingCheckRule(t *testing.T) *configs.CheckRule {
+	t.Helper()
+
+	condition, diags := hclsyntax.ParseExpression([]byte("false"), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("failed to parse condition: %s", diags)
+	}
+	message, diags := hclsyntax.ParseExpression([]byte(`"condition failed"`), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("failed to parse error message: %s", diags)
+	}
+
+	return &configs.CheckRule{
+		Condition:    condition,
+		ErrorMessage: message,
+	}
+}
+
+func testResourceInstanceAddr() addrs.AbsResourceInstance {
+	return addrs.Resource{
+		Mode: addrs.ManagedResourceMode,
+		Type: "test_resource",
+		Name: "foo",
+	}.Instance(addrs.NoKey).Absolute(addrs.RootModuleInstance)
+}
+
+func TestEvalResourcePrecondition_RefreshOnlyProducesWarning(t *testing.T) {
+	addr := testResourceInstanceAddr()
+	rules := []*configs.CheckRule{failingCheckRule(t)}
+	ctx := &fakeCheckEvalContext{mode: plans.RefreshOnlyMode}
+
+	results, diags := evalResourcePrecondition(addr, rules, ctx, instances.RepetitionData{})
+	if !diags.HasErrors() && !diags.HasWarnings() {
+		t.Fatalf("expected diagnostics for the failed precondition, got none")
+	}
+	for _, d := range diags {
+		if d.Severity() != tfdiags.Warning {
+			t.Errorf("wrong severity %v; want warning in refresh-only mode", d.Severity())
+		}
+	}
+	if diags.HasErrors() {
+		t.Errorf("refresh-only precondition failure must not be an error")
+	}
+
+	if len(results) != 1 || results[0].Status != checks.StatusFail {
+		t.Fatalf("expected one failed check result, got %#v", results)
+	}
+
+	accumulated := ctx.Checks().Plan().Results
+	if len(accumulated) != 1 {
+		t.Fatalf("expected the result to also be reported onto the accumulator, got %#v", accumulated)
+	}
+}
+
+func TestRefreshResourceInstance_ReadsAndReportsPostconditionWarning(t *testing.T) {
+	addr := testResourceInstanceAddr()
+	rules := []*configs.CheckRule{failingCheckRule(t)}
+	ctx := &fakeCheckEvalContext{mode: plans.RefreshOnlyMode}
+	provider := &fakeReadResourceProvider{
+		newState: cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("abc123")}),
+	}
+
+	_, results, diags := refreshResourceInstance(addr, rules, ctx, provider, cty.NilVal, instances.RepetitionData{})
+
+	if !provider.called {
+		t.Fatal("expected ReadResource to be called during a refresh-only plan")
+	}
+	if diags.HasErrors() {
+		t.Fatalf("refresh-only postcondition failure must not be an error: %s", diags.Err())
+	}
+	for _, d := range diags {
+		if d.Severity() != tfdiags.Warning {
+			t.Errorf("wrong severity %v; want warning in refresh-only mode", d.Severity())
+		}
+	}
+	if len(results) != 1 || results[0].Status != checks.StatusFail {
+		t.Fatalf("expected one failed postcondition result, got %#v", results)
+	}
+}
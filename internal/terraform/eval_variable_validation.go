@@ -0,0 +1,15 @@
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
+	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/instances"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// evalVariableValidations evaluates the validation blocks, if any, attached
+// to an input variable, against the value that was just assigned to it.
+func evalVariableValidations(addr addrs.AbsInputVariableInstance, rules []*configs.CheckRule, ctx checkEvalContext, keyData instances.RepetitionData) ([]*checks.Result, tfdiags.Diagnostics) {
+	return evalCheckRules(checkVariableValidation, rules, ctx, addr.Variable, addr, keyData)
+}
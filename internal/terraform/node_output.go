@@ -0,0 +1,16 @@
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
+	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/instances"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// evalOutputPrecondition evaluates the precondition blocks, if any,
+// attached to a module output value, prior to that output's expression
+// being evaluated.
+func evalOutputPrecondition(addr addrs.AbsOutputValue, rules []*configs.CheckRule, ctx checkEvalContext, keyData instances.RepetitionData) ([]*checks.Result, tfdiags.Diagnostics) {
+	return evalCheckRules(checkOutputPrecondition, rules, ctx, addr.OutputValue, addr, keyData)
+}
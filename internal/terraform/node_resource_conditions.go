@@ -0,0 +1,34 @@
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
+	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/instances"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// evalResourcePrecondition evaluates the precondition blocks, if any,
+// attached to the resource being planned or applied. It's called by the
+// plan and apply graph nodes for a resource instance just before
+// reading or changing the remote object.
+//
+// This runs during refresh-only plans too, so that a drifted object that
+// no longer satisfies its precondition is reported; evalCheckRules takes
+// care of downgrading that report to a warning in that mode, since a
+// refresh-only plan has no change for the failure to block.
+func evalResourcePrecondition(addr addrs.AbsResourceInstance, rules []*configs.CheckRule, ctx checkEvalContext, keyData instances.RepetitionData) ([]*checks.Result, tfdiags.Diagnostics) {
+	return evalCheckRules(checkResourcePrecondition, rules, ctx, addr.Resource, addr, keyData)
+}
+
+// evalResourcePostcondition evaluates the postcondition blocks, if any,
+// attached to the resource being planned or applied, against the new
+// value produced for that resource instance.
+//
+// This also runs during refresh-only plans, evaluated against whatever
+// was just read from the provider, so that drift which violates a
+// postcondition is surfaced as part of the refresh rather than silently
+// skipped.
+func evalResourcePostcondition(addr addrs.AbsResourceInstance, rules []*configs.CheckRule, ctx checkEvalContext, keyData instances.RepetitionData) ([]*checks.Result, tfdiags.Diagnostics) {
+	return evalCheckRules(checkResourcePostcondition, rules, ctx, addr.Resource, addr, keyData)
+}
@@ -0,0 +1,51 @@
+package terraform
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform/internal/addrs"
+	"github.com/hashicorp/terraform/internal/checks"
+	"github.com/hashicorp/terraform/internal/configs"
+	"github.com/hashicorp/terraform/internal/instances"
+	"github.com/hashicorp/terraform/internal/providers"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+)
+
+// resourceReader is the subset of providers.Interface that
+// refreshResourceInstance needs in order to refresh a resource
+// instance's object. It's expressed as its own interface, rather than
+// depending on providers.Interface directly, so that a refresh can be
+// exercised in tests without having to stub out a whole provider.
+type resourceReader interface {
+	ReadResource(providers.ReadResourceRequest) providers.ReadResourceResponse
+}
+
+// refreshResourceInstance reads the current state of a managed resource
+// instance from its provider and evaluates the resource's postconditions,
+// if any, against the refreshed object.
+//
+// This always calls ReadResource, even during a refresh-only plan: the
+// whole purpose of that mode is to reconcile state with reality, so
+// skipping the read would defeat it. A postcondition that the refreshed
+// object no longer satisfies is still reported -- evalResourcePostcondition
+// takes care of downgrading that to a warning in refresh-only mode, so a
+// single drifted attribute doesn't abort the rest of the refresh. The
+// postcondition's structured results are returned to the caller so they
+// can be aggregated onto the plan just like every other check result.
+func refreshResourceInstance(addr addrs.AbsResourceInstance, rules []*configs.CheckRule, ctx checkEvalContext, provider resourceReader, priorState cty.Value, keyData instances.RepetitionData) (cty.Value, []*checks.Result, tfdiags.Diagnostics) {
+	var diags tfdiags.Diagnostics
+
+	resp := provider.ReadResource(providers.ReadResourceRequest{
+		TypeName:   addr.Resource.Resource.Type,
+		PriorState: priorState,
+	})
+	diags = diags.Append(resp.Diagnostics)
+	if diags.HasErrors() {
+		return cty.NilVal, nil, diags
+	}
+
+	results, moreDiags := evalResourcePostcondition(addr, rules, ctx, keyData)
+	diags = diags.Append(moreDiags)
+
+	return resp.NewState, results, diags
+}
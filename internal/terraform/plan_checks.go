@@ -0,0 +1,17 @@
+package terraform
+
+import (
+	"github.com/hashicorp/terraform/internal/plans"
+)
+
+// finalizePlanChecks assembles the check results accumulated on ctx
+// while walking the plan graph into a checks.Plan and attaches it to the
+// plan being built, so that consumers such as the JSON plan formatter
+// can see the outcome of every precondition, postcondition, and
+// validation that was evaluated along the way.
+//
+// This is called once, after the graph walk that produced plan has
+// finished.
+func finalizePlanChecks(ctx checkEvalContext, plan *plans.Plan) {
+	plan.Checks = ctx.Checks().Plan()
+}